@@ -1,13 +1,46 @@
 package main
 
 import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
 	"log"
+	"net"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 )
 
+// Files the launcher hands to "go run"
+var (
+	serverFiles = []string{"server/main.go", "server/database.go", "server/api.go"}
+	clientFiles = []string{"client/main.go", "client/scanner.go"}
+)
+
+// Fixed port the server binds (static/clock.js connects to it) and the
+// database file it creates in the working directory
+const (
+	serverAddr = ":8080"
+	dbFile     = "rfid_attendance.db"
+)
+
 func main() {
+	checkOnly := flag.Bool("check", false, "validate the environment and exit without starting")
+	flag.Parse()
+
+	// Validate the environment before anything is launched
+	if !runChecks() {
+		log.Fatal("❌ Startup check failed, fix the problems above and try again")
+	}
+	if *checkOnly {
+		log.Println("✅ All startup checks passed")
+		return
+	}
+
 	log.Println("🚀 Starting RFID Attendance System...")
 
 	// Start the server in a Goroutine
@@ -20,10 +53,125 @@ func main() {
 	startClient()
 }
 
+// A single startup check; fatal checks stop the launch
+type check struct {
+	name  string
+	fatal bool
+	run   func() error
+}
+
+// Function to run every startup check and print a pass/fail checklist
+func runChecks() bool {
+	checks := []check{
+		{"Go toolchain on PATH", true, checkGoToolchain},
+		{"Server sources present", true, func() error { return checkFiles(serverFiles) }},
+		{"Client sources present", true, func() error { return checkFiles(clientFiles) }},
+		{"Templates directory present", true, func() error { return checkDir("template") }},
+		{"Static directory present", true, func() error { return checkDir("static") }},
+		{"Database " + dbFile + " writable", true, checkDatabaseWritable},
+		{"Port " + serverAddr + " bindable", true, func() error { return checkPort(serverAddr) }},
+		{"Timezone valid", false, checkTimezone},
+	}
+
+	ok := true
+	for _, c := range checks {
+		if err := c.run(); err != nil {
+			if c.fatal {
+				ok = false
+				log.Printf("❌ %s: %v", c.name, err)
+			} else {
+				log.Printf("⚠️ %s: %v", c.name, err)
+			}
+			continue
+		}
+		log.Printf("✅ %s", c.name)
+	}
+	return ok
+}
+
+func checkGoToolchain() error {
+	_, err := exec.LookPath("go")
+	return err
+}
+
+func checkFiles(files []string) error {
+	for _, f := range files {
+		if _, err := os.Stat(f); err != nil {
+			return fmt.Errorf("%s: %w", f, err)
+		}
+	}
+	return nil
+}
+
+func checkDir(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	return nil
+}
+
+// The SQLite database is created in the working directory, so the directory
+// must accept new files and an existing database file must be writable
+func checkDatabaseWritable() error {
+	f, err := os.CreateTemp(".", ".punchpi-check-*")
+	if err != nil {
+		return err
+	}
+	name := f.Name()
+	f.Close()
+	if err := os.Remove(name); err != nil {
+		return err
+	}
+
+	db, err := os.OpenFile(dbFile, os.O_WRONLY, 0)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+func checkPort(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return ln.Close()
+}
+
+// Mirrors the runtime's TZ handling: unset uses /etc/localtime, empty means
+// UTC, a leading ":" is ignored and an absolute path names a tzdata file
+func checkTimezone() error {
+	tz, ok := os.LookupEnv("TZ")
+	if !ok {
+		return nil
+	}
+	tz = strings.TrimPrefix(tz, ":")
+	if tz == "" {
+		return nil
+	}
+	if filepath.IsAbs(tz) {
+		data, err := os.ReadFile(tz)
+		if err != nil {
+			return err
+		}
+		_, err = time.LoadLocationFromTZData(tz, data)
+		return err
+	}
+	_, err := time.LoadLocation(tz)
+	return err
+}
+
 // Function to start the server
 func startServer() {
 	log.Println("🖥️ Starting the server...")
-	cmd := exec.Command("go", "run", "server/main.go", "server/database.go", "server/api.go")
+	cmd := exec.Command("go", append([]string{"run"}, serverFiles...)...)
 	cmd.Stdout = log.Writer()
 	cmd.Stderr = log.Writer()
 
@@ -38,11 +186,12 @@ func startClient() {
 	log.Println("💳 Starting the RFID client...")
 
 	// Define the command based on OS
+	args := append([]string{"run"}, clientFiles...)
 	var cmd *exec.Cmd
 	if runtime.GOOS == "windows" {
-		cmd = exec.Command("cmd", "/C", "go", "run", "client/main.go", "client/scanner.go")
+		cmd = exec.Command("cmd", append([]string{"/C", "go"}, args...)...)
 	} else {
-		cmd = exec.Command("go", "run", "client/main.go", "client/scanner.go")
+		cmd = exec.Command("go", args...)
 	}
 
 	cmd.Stdout = log.Writer()